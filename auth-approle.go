@@ -33,6 +33,14 @@ func NewAppRole(path, appRoleName, role_id string) (approle *AppRole) {
 	}
 }
 
+// Invalidate - drops the cached token so the next GetToken call logs in again from scratch
+func (ar *AppRole) Invalidate() {
+	ar.mutex.Lock()
+	defer ar.mutex.Unlock()
+	ar.auth = nil
+	ar.expires = time.Time{}
+}
+
 // SetSecretID - sets a secret id for a given AppRole for a configured `role_id`
 func (ar *AppRole) SetSecretID(secret_id string) {
 	ar.secret_id = secret_id
@@ -0,0 +1,161 @@
+package vault
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/aws/signer/v4"
+	hvault "github.com/hashicorp/vault/api"
+)
+
+// AWSAuthPath - default aws auth path
+const AWSAuthPath = "aws"
+
+// awsSTSRequestBody - body of the `sts:GetCallerIdentity` request used to prove AWS identity to Vault
+const awsSTSRequestBody = "Action=GetCallerIdentity&Version=2011-06-15"
+
+// AWSAuth - vault AWS IAM authentication information
+type AWSAuth struct {
+	roleName string
+	region   string
+	path     string
+	auth     *hvault.SecretAuth
+	expires  time.Time
+	mutex    sync.Mutex
+}
+
+// NewAWSAuth - creates a new instance of AWSAuth for the given `roleName`, logging in against the IAM auth method mounted at `path`
+func NewAWSAuth(path, roleName string) (a *AWSAuth) {
+	return &AWSAuth{
+		roleName: roleName,
+		path:     "auth/" + path,
+	}
+}
+
+// Invalidate - drops the cached token so the next GetToken call logs in again from scratch
+func (a *AWSAuth) Invalidate() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	a.auth = nil
+	a.expires = time.Time{}
+}
+
+// SetRegion - overrides the AWS region used to sign the `sts:GetCallerIdentity` request, defaults to the region resolved by the AWS SDK
+func (a *AWSAuth) SetRegion(region string) {
+	a.region = region
+}
+
+// GetToken - returns a token for the configured `roleName`
+func (a *AWSAuth) GetToken(c *hvault.Client) (token string, err error) {
+
+	// lock reading the latest token
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	if nil != a.auth {
+		if len(a.auth.ClientToken) != 0 && time.Now().UTC().Before(a.expires) {
+			return a.auth.ClientToken, nil // returns valid saved token
+		} else {
+			err = a.renew(c) // try to renew the existing token
+			if nil == err {
+				return a.auth.ClientToken, err // if no error is detected, the renew was successful, otherwise we fallback to creating a new token
+			}
+		}
+	}
+
+	err = a.new(c) // create new token
+	if nil != err {
+		return "", fmt.Errorf("gettoken: %w", err)
+	}
+
+	return a.auth.ClientToken, err
+}
+
+// new - signs a `sts:GetCallerIdentity` request using the AWS SDK's default credential chain and logs in with it
+func (a *AWSAuth) new(c *hvault.Client) (err error) {
+
+	sess, err := session.NewSession()
+	if nil != err {
+		return fmt.Errorf("new: %w", err)
+	}
+
+	region := a.region
+	if len(region) == 0 {
+		region = sessionRegion(sess)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("https://sts.%s.amazonaws.com/", region), strings.NewReader(awsSTSRequestBody))
+	if nil != err {
+		return fmt.Errorf("new: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+
+	signer := v4.NewSigner(sess.Config.Credentials)
+	_, err = signer.Sign(req, strings.NewReader(awsSTSRequestBody), "sts", region, time.Now())
+	if nil != err {
+		return fmt.Errorf("new: %w", err)
+	}
+
+	headers, err := json.Marshal(req.Header)
+	if nil != err {
+		return fmt.Errorf("new: %w", err)
+	}
+
+	d := NewData()
+	d.SetString("role", a.roleName)
+	d.SetString("iam_http_request_method", req.Method)
+	d.SetString("iam_request_url", base64.StdEncoding.EncodeToString([]byte(req.URL.String())))
+	d.SetString("iam_request_body", base64.StdEncoding.EncodeToString([]byte(awsSTSRequestBody)))
+	d.SetString("iam_request_headers", base64.StdEncoding.EncodeToString(headers))
+
+	secret, err := c.Logical().Write(a.path+"/login", d)
+	if nil != err {
+		return fmt.Errorf("new: %w", err)
+	}
+
+	a.auth = secret.Auth
+	a.setexpiry()
+
+	return
+}
+
+// renew - renews an existing token obtained from login
+func (a *AWSAuth) renew(c *hvault.Client) (err error) {
+
+	if len(a.auth.ClientToken) == 0 {
+		return fmt.Errorf("renew: missing 'token' for renewal")
+	}
+
+	c.SetToken(a.auth.ClientToken)
+	secret, err := c.Logical().Write("auth/token/renew-self", nil)
+	if nil != err {
+		return fmt.Errorf("renew: %w", err)
+	}
+
+	a.auth = secret.Auth
+	a.setexpiry()
+
+	return
+}
+
+// setexpiry - sets an expiry datetime
+func (a *AWSAuth) setexpiry() {
+	if a.auth.LeaseDuration > 20 {
+		a.auth.LeaseDuration -= 10 // we set our maximum to be 10 seconds less than expiry
+	}
+	a.expires = time.Now().Add(time.Duration(a.auth.LeaseDuration) * time.Second).UTC()
+}
+
+// sessionRegion - resolves the region configured on an AWS session, falling back to the global STS endpoint's default region
+func sessionRegion(sess *session.Session) string {
+	if sess.Config != nil && sess.Config.Region != nil && len(*sess.Config.Region) != 0 {
+		return *sess.Config.Region
+	}
+	return "us-east-1"
+}
@@ -0,0 +1,135 @@
+package vault
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	hvault "github.com/hashicorp/vault/api"
+)
+
+// JWTAuthPath - default jwt/oidc auth path
+const JWTAuthPath = "jwt"
+
+// JWTAuth - vault JWT/OIDC authentication information
+type JWTAuth struct {
+	roleName string
+	jwt      string
+	jwtFile  string
+	path     string
+	auth     *hvault.SecretAuth
+	expires  time.Time
+	mutex    sync.Mutex
+}
+
+// NewJWTAuth - creates a new instance of JWTAuth for the given `roleName`, logging in against the JWT/OIDC auth method mounted at `path`
+func NewJWTAuth(path, roleName string) (j *JWTAuth) {
+	return &JWTAuth{
+		roleName: roleName,
+		path:     "auth/" + path,
+	}
+}
+
+// Invalidate - drops the cached token so the next GetToken call logs in again from scratch
+func (j *JWTAuth) Invalidate() {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+	j.auth = nil
+	j.expires = time.Time{}
+}
+
+// SetJWT - sets the raw JWT to log in with
+func (j *JWTAuth) SetJWT(jwt string) {
+	j.jwt = jwt
+}
+
+// SetJWTFile - sets a file to read the JWT from
+func (j *JWTAuth) SetJWTFile(jwtFile string) {
+	j.jwtFile = jwtFile
+}
+
+// GetToken - returns a token for the configured `roleName`
+func (j *JWTAuth) GetToken(c *hvault.Client) (token string, err error) {
+
+	// lock reading the latest token
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if nil != j.auth {
+		if len(j.auth.ClientToken) != 0 && time.Now().UTC().Before(j.expires) {
+			return j.auth.ClientToken, nil // returns valid saved token
+		} else {
+			err = j.renew(c) // try to renew the existing token
+			if nil == err {
+				return j.auth.ClientToken, err // if no error is detected, the renew was successful, otherwise we fallback to creating a new token
+			}
+		}
+	}
+
+	err = j.new(c) // create new token
+	if nil != err {
+		return "", fmt.Errorf("gettoken: %w", err)
+	}
+
+	return j.auth.ClientToken, err
+}
+
+// new - logs in with the configured JWT, preferring a file over the raw value if both are given
+func (j *JWTAuth) new(c *hvault.Client) (err error) {
+
+	jwt := j.jwt
+
+	if len(j.jwtFile) != 0 {
+		bytes, err := ioutil.ReadFile(j.jwtFile)
+		if nil != err {
+			return fmt.Errorf("new: %w", err)
+		}
+		jwt = string(bytes)
+	}
+
+	if len(jwt) == 0 {
+		return fmt.Errorf("new: missing 'jwt' to get new token")
+	}
+
+	d := NewData()
+	d.SetString("role", j.roleName)
+	d.SetString("jwt", jwt)
+
+	secret, err := c.Logical().Write(j.path+"/login", d)
+	if nil != err {
+		return fmt.Errorf("new: %w", err)
+	}
+
+	j.auth = secret.Auth
+	j.setexpiry()
+
+	return
+}
+
+// renew - renews an existing token obtained from login
+func (j *JWTAuth) renew(c *hvault.Client) (err error) {
+
+	if len(j.auth.ClientToken) == 0 {
+		return fmt.Errorf("renew: missing 'token' for renewal")
+	}
+
+	c.SetToken(j.auth.ClientToken)
+	secret, err := c.Logical().Write("auth/token/renew-self", nil)
+	if nil != err {
+		return fmt.Errorf("renew: %w", err)
+	}
+
+	j.auth = secret.Auth
+	j.setexpiry()
+
+	return
+}
+
+// setexpiry - sets an expiry datetime
+func (j *JWTAuth) setexpiry() {
+	if j.auth.LeaseDuration > 20 {
+		j.auth.LeaseDuration -= 10 // we set our maximum to be 10 seconds less than expiry
+	}
+	j.expires = time.Now().Add(time.Duration(j.auth.LeaseDuration) * time.Second).UTC()
+}
@@ -0,0 +1,124 @@
+package vault
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sync"
+	"time"
+
+	hvault "github.com/hashicorp/vault/api"
+)
+
+// KubernetesAuthPath - default kubernetes auth path
+const KubernetesAuthPath = "kubernetes"
+
+// kubernetesServiceAccountTokenFile - default location of the pod's projected service account token
+const kubernetesServiceAccountTokenFile = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+
+// KubernetesAuth - vault Kubernetes authentication information
+type KubernetesAuth struct {
+	roleName  string
+	tokenFile string
+	path      string
+	auth      *hvault.SecretAuth
+	expires   time.Time
+	mutex     sync.Mutex
+}
+
+// NewKubernetesAuth - creates a new instance of KubernetesAuth for the given `roleName`, logging in against the Kubernetes auth method mounted at `path`
+func NewKubernetesAuth(path, roleName string) (k *KubernetesAuth) {
+	return &KubernetesAuth{
+		roleName:  roleName,
+		path:      "auth/" + path,
+		tokenFile: kubernetesServiceAccountTokenFile,
+	}
+}
+
+// Invalidate - drops the cached token so the next GetToken call logs in again from scratch
+func (k *KubernetesAuth) Invalidate() {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+	k.auth = nil
+	k.expires = time.Time{}
+}
+
+// SetTokenFile - overrides the default location of the pod's service account token
+func (k *KubernetesAuth) SetTokenFile(tokenFile string) {
+	k.tokenFile = tokenFile
+}
+
+// GetToken - returns a token for the configured `roleName`
+func (k *KubernetesAuth) GetToken(c *hvault.Client) (token string, err error) {
+
+	// lock reading the latest token
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	if nil != k.auth {
+		if len(k.auth.ClientToken) != 0 && time.Now().UTC().Before(k.expires) {
+			return k.auth.ClientToken, nil // returns valid saved token
+		} else {
+			err = k.renew(c) // try to renew the existing token
+			if nil == err {
+				return k.auth.ClientToken, err // if no error is detected, the renew was successful, otherwise we fallback to creating a new token
+			}
+		}
+	}
+
+	err = k.new(c) // create new token
+	if nil != err {
+		return "", fmt.Errorf("gettoken: %w", err)
+	}
+
+	return k.auth.ClientToken, err
+}
+
+// new - reads the pod's service account JWT and logs in with it
+func (k *KubernetesAuth) new(c *hvault.Client) (err error) {
+
+	jwt, err := ioutil.ReadFile(k.tokenFile)
+	if nil != err {
+		return fmt.Errorf("new: %w", err)
+	}
+
+	d := NewData()
+	d.SetString("role", k.roleName)
+	d.SetString("jwt", string(jwt))
+
+	secret, err := c.Logical().Write(k.path+"/login", d)
+	if nil != err {
+		return fmt.Errorf("new: %w", err)
+	}
+
+	k.auth = secret.Auth
+	k.setexpiry()
+
+	return
+}
+
+// renew - renews an existing token obtained from login
+func (k *KubernetesAuth) renew(c *hvault.Client) (err error) {
+
+	if len(k.auth.ClientToken) == 0 {
+		return fmt.Errorf("renew: missing 'token' for renewal")
+	}
+
+	c.SetToken(k.auth.ClientToken)
+	secret, err := c.Logical().Write("auth/token/renew-self", nil)
+	if nil != err {
+		return fmt.Errorf("renew: %w", err)
+	}
+
+	k.auth = secret.Auth
+	k.setexpiry()
+
+	return
+}
+
+// setexpiry - sets an expiry datetime
+func (k *KubernetesAuth) setexpiry() {
+	if k.auth.LeaseDuration > 20 {
+		k.auth.LeaseDuration -= 10 // we set our maximum to be 10 seconds less than expiry
+	}
+	k.expires = time.Now().Add(time.Duration(k.auth.LeaseDuration) * time.Second).UTC()
+}
@@ -29,6 +29,13 @@ func (t *Token) SetToken(token string) {
 	t.token = token
 }
 
+// Invalidate - drops the cached lookup-self expiry so the next GetToken call re-validates the token
+func (t *Token) Invalidate() {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	t.expires = time.Time{}
+}
+
 // SetTokenFile - sets a file to read the token from
 func (t *Token) SetTokenFile(token_file string) (err error) {
 	t.token_file = token_file
@@ -2,8 +2,12 @@ package vault
 
 import (
 	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
 )
 
 // NewData - creates new instance of vault data
@@ -60,6 +64,111 @@ func (d Data) GetBytes(field string) (bytes []byte, err error) {
 	return base64.URLEncoding.DecodeString(value)
 }
 
+// GetInt64 - returns int64 value from a given field, handling Vault's `json.Number`-typed responses as well as native numbers and strings
+func (d Data) GetInt64(field string) (value int64) {
+	switch v := d[field].(type) {
+	case json.Number:
+		value, _ = v.Int64()
+	case float64:
+		value = int64(v)
+	case int64:
+		value = v
+	case int:
+		value = int64(v)
+	case string:
+		value, _ = strconv.ParseInt(v, 10, 64)
+	}
+
+	return
+}
+
+// GetFloat64 - returns float64 value from a given field, handling Vault's `json.Number`-typed responses as well as native numbers and strings
+func (d Data) GetFloat64(field string) (value float64) {
+	switch v := d[field].(type) {
+	case json.Number:
+		value, _ = v.Float64()
+	case float64:
+		value = v
+	case int64:
+		value = float64(v)
+	case int:
+		value = float64(v)
+	case string:
+		value, _ = strconv.ParseFloat(v, 64)
+	}
+
+	return
+}
+
+// GetTime - returns time.Time value from a given field, parsed using the given layout (e.g. time.RFC3339)
+func (d Data) GetTime(field, layout string) (value time.Time, err error) {
+	v, ok := d[field].(string)
+	if !ok {
+		return time.Time{}, fmt.Errorf("gettime: no such field " + field)
+	}
+
+	return time.Parse(layout, v)
+}
+
+// GetStringSlice - returns []string value from a given field
+func (d Data) GetStringSlice(field string) (values []string) {
+	list, ok := d[field].([]interface{})
+	if !ok {
+		return nil
+	}
+
+	for _, v := range list {
+		if s, ok := v.(string); ok {
+			values = append(values, s)
+		}
+	}
+
+	return
+}
+
+// GetSubData - returns the nested map under a given field as a Data, useful for Vault responses with nested objects
+func (d Data) GetSubData(field string) (sub Data) {
+	m, ok := d[field].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+
+	return Data(m)
+}
+
+// Decode - populates the given struct from this Data using `vault` struct tags
+func (d Data) Decode(out interface{}) (err error) {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		TagName:          "vault",
+		WeaklyTypedInput: true,
+		Result:           out,
+	})
+	if nil != err {
+		return fmt.Errorf("decode: %w", err)
+	}
+
+	return decoder.Decode(map[string]interface{}(d))
+}
+
+// NewDataFromStruct - creates a new instance of vault data from the given struct, using `vault` struct tags
+func NewDataFromStruct(v interface{}) (d Data, err error) {
+	m := make(map[string]interface{})
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		TagName: "vault",
+		Result:  &m,
+	})
+	if nil != err {
+		return nil, fmt.Errorf("newdatafromstruct: %w", err)
+	}
+
+	if err = decoder.Decode(v); nil != err {
+		return nil, fmt.Errorf("newdatafromstruct: %w", err)
+	}
+
+	return Data(m), nil
+}
+
 // SetString - sets a field and value in string format
 func (d Data) SetString(field, value string) {
 	d[field] = value
@@ -79,3 +188,32 @@ func (d Data) SetUint64(field string, value uint64) {
 func (d Data) SetBytes(field string, value []byte) {
 	d[field] = base64.URLEncoding.EncodeToString(value)
 }
+
+// SetInt64 - sets a field and value in int64 format
+func (d Data) SetInt64(field string, value int64) {
+	d[field] = strconv.FormatInt(value, 10)
+}
+
+// SetFloat64 - sets a field and value in float64 format
+func (d Data) SetFloat64(field string, value float64) {
+	d[field] = strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+// SetTime - sets a field and value formatted using the given layout (e.g. time.RFC3339)
+func (d Data) SetTime(field string, value time.Time, layout string) {
+	d[field] = value.Format(layout)
+}
+
+// SetStringSlice - sets a field and value in []string format, stored as []interface{} for symmetry with GetStringSlice and Vault's own JSON responses
+func (d Data) SetStringSlice(field string, value []string) {
+	list := make([]interface{}, len(value))
+	for i, s := range value {
+		list[i] = s
+	}
+	d[field] = list
+}
+
+// SetSubData - sets a field to a nested Data value
+func (d Data) SetSubData(field string, value Data) {
+	d[field] = map[string]interface{}(value)
+}
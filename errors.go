@@ -1,9 +1,45 @@
 package vault
 
 import (
+	"errors"
 	"fmt"
 )
 
+// ErrPermissionDenied - sentinel error for a 403 permission denied response from Vault
+var ErrPermissionDenied = errors.New("vault: permission denied")
+
+// ErrSealed - sentinel error for operations attempted while Vault is sealed
+var ErrSealed = errors.New("vault: sealed")
+
+// ErrTokenRevoked - sentinel error for a 403 response indicating the current token has been revoked
+var ErrTokenRevoked = errors.New("vault: token revoked")
+
+// RecoverableError - wraps an error considered transient (5xx, connection issues, seal-in-progress), signalling that the call is safe to retry
+type RecoverableError struct {
+	err error
+}
+
+// NewRecoverableError - wraps `err` as a RecoverableError
+func NewRecoverableError(err error) *RecoverableError {
+	return &RecoverableError{err: err}
+}
+
+// Error - returns the wrapped error's message
+func (r *RecoverableError) Error() string {
+	return r.err.Error()
+}
+
+// Unwrap - returns the wrapped error, so errors.Is/As see through to it
+func (r *RecoverableError) Unwrap() error {
+	return r.err
+}
+
+// IsRecoverable - reports whether err is a RecoverableError, or wraps one
+func IsRecoverable(err error) bool {
+	var r *RecoverableError
+	return errors.As(err, &r)
+}
+
 // NewKeyError - creates a new instance of key error
 func NewKeyError(key string) (k *KeyError) {
 	k = new(KeyError)
@@ -0,0 +1,313 @@
+package vault
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	hvault "github.com/hashicorp/vault/api"
+)
+
+// Option - configures a Client at construction time
+type Option func(*Client)
+
+// WithKVVersion - explicitly sets the KV engine version mounted at `store`, bypassing the `sys/mounts` auto-detection done on first use
+func WithKVVersion(version int) Option {
+	return func(v *Client) {
+		v.kvVersion = version
+	}
+}
+
+// WriteOptions - options controlling how Write behaves against a KV v2 mount, ignored on KV v1
+type WriteOptions struct {
+	CAS *int // when set, the write only succeeds if the path's current version matches CAS
+}
+
+// KVMetadata - metadata about all versions of a value stored under a path, only available on a KV v2 mount
+type KVMetadata struct {
+	CurrentVersion int
+	OldestVersion  int
+	CreatedTime    string
+	UpdatedTime    string
+	Versions       map[string]Data
+}
+
+// ensureKVVersion - detects and caches the KV engine version mounted at `store`, a no-op once already known or explicitly set via `WithKVVersion`
+func (v *Client) ensureKVVersion() (err error) {
+
+	if v.kvVersion != 0 {
+		return nil
+	}
+
+	mounts, err := v.client.Sys().ListMounts()
+	if nil != err {
+		return fmt.Errorf("ensurekvversion: %w", err)
+	}
+
+	mount, ok := mounts[v.store+"/"]
+	if !ok {
+		v.kvVersion = 1 // assume v1 if the mount can't be inspected, this preserves the library's original behaviour
+		return nil
+	}
+
+	v.kvVersion = 1
+	if nil != mount.Options && mount.Options["version"] == "2" {
+		v.kvVersion = 2
+	}
+
+	return nil
+}
+
+// dataPath - returns the path used to read/write/delete the current version of the secret data under `path`
+func (v *Client) dataPath(path string) string {
+	if v.kvVersion == 2 {
+		return v.store + "/data/" + path
+	}
+	return v.store + "/" + path
+}
+
+// listPath - returns the path used to list keys under `path`
+func (v *Client) listPath(path string) string {
+	if v.kvVersion == 2 {
+		return v.store + "/metadata/" + path
+	}
+	return v.store + "/" + path
+}
+
+// unwrapKVv2Data - extracts the secret payload from a KV v2 response, which nests it under a "data" key alongside version metadata
+func unwrapKVv2Data(raw map[string]interface{}) (data Data, err error) {
+	inner, ok := raw["data"].(map[string]interface{})
+	if !ok {
+		return nil, errors.New("unwrapkvv2data: missing 'data' in KV v2 response")
+	}
+	return Data(inner), nil
+}
+
+// numberToInt - best-effort conversion of Vault's numeric JSON fields (json.Number, float64) into an int
+func numberToInt(v interface{}) int {
+	switch n := v.(type) {
+	case json.Number:
+		i, _ := n.Int64()
+		return int(i)
+	case float64:
+		return int(n)
+	}
+	return 0
+}
+
+// ReadVersion - reads a specific version of the data under the given path, requires a KV v2 mount
+func (v *Client) ReadVersion(path string, version int) (data Data, err error) {
+
+	sealed, token, err := v.sealedAndToken("readversion")
+	if nil != err {
+		return nil, fmt.Errorf("readversion: %w", err)
+	}
+
+	if sealed {
+		return nil, fmt.Errorf("readversion: %w", ErrSealed)
+	}
+
+	v.client.SetToken(token)
+
+	if err = v.ensureKVVersion(); nil != err {
+		return nil, fmt.Errorf("readversion: %w", err)
+	}
+
+	if v.kvVersion != 2 {
+		return nil, fmt.Errorf("readversion: versioned reads require a KV v2 mount")
+	}
+
+	c := v.client.Logical()
+	if nil == c {
+		return nil, errors.New("readversion: error creating logical client for Vault")
+	}
+
+	secret, err := v.retryLogical("readversion", func() (*hvault.Secret, error) {
+		return c.ReadWithData(v.dataPath(path), map[string][]string{"version": {fmt.Sprintf("%d", version)}})
+	})
+	if nil != err {
+		return nil, fmt.Errorf("readversion: %w", err)
+	}
+
+	if nil == secret {
+		return nil, fmt.Errorf("readversion: %w", NewKeyError(path))
+	}
+
+	return unwrapKVv2Data(secret.Data)
+}
+
+// Patch - partially updates the data under the given path without replacing existing fields, requires a KV v2 mount
+func (v *Client) Patch(path string, d Data) (data Data, err error) {
+
+	sealed, token, err := v.sealedAndToken("patch")
+	if nil != err {
+		return nil, fmt.Errorf("patch: %w", err)
+	}
+
+	if sealed {
+		return nil, fmt.Errorf("patch: %w", ErrSealed)
+	}
+
+	v.client.SetToken(token)
+
+	if err = v.ensureKVVersion(); nil != err {
+		return nil, fmt.Errorf("patch: %w", err)
+	}
+
+	if v.kvVersion != 2 {
+		return nil, fmt.Errorf("patch: partial updates require a KV v2 mount")
+	}
+
+	c := v.client.Logical()
+	if nil == c {
+		return nil, errors.New("patch: error creating logical client for Vault")
+	}
+
+	secret, err := v.retryLogical("patch", func() (*hvault.Secret, error) {
+		return c.JSONMergePatch(context.Background(), v.dataPath(path), map[string]interface{}{"data": d})
+	})
+	if nil != err {
+		return nil, fmt.Errorf("patch: %w", err)
+	}
+
+	if nil != secret && nil != secret.Data {
+		data = secret.Data
+	}
+
+	return
+}
+
+// Undelete - restores the given soft-deleted versions of the data under path, requires a KV v2 mount
+func (v *Client) Undelete(path string, versions []int) (err error) {
+
+	sealed, token, err := v.sealedAndToken("undelete")
+	if nil != err {
+		return fmt.Errorf("undelete: %w", err)
+	}
+
+	if sealed {
+		return fmt.Errorf("undelete: %w", ErrSealed)
+	}
+
+	v.client.SetToken(token)
+
+	if err = v.ensureKVVersion(); nil != err {
+		return fmt.Errorf("undelete: %w", err)
+	}
+
+	if v.kvVersion != 2 {
+		return fmt.Errorf("undelete: requires a KV v2 mount")
+	}
+
+	c := v.client.Logical()
+	if nil == c {
+		return errors.New("undelete: error creating logical client for Vault")
+	}
+
+	_, err = v.retryLogical("undelete", func() (*hvault.Secret, error) {
+		return c.Write(v.store+"/undelete/"+path, map[string]interface{}{"versions": versions})
+	})
+	if nil != err {
+		return fmt.Errorf("undelete: %w", err)
+	}
+
+	return
+}
+
+// Destroy - permanently destroys the given versions of the data under path, requires a KV v2 mount
+func (v *Client) Destroy(path string, versions []int) (err error) {
+
+	sealed, token, err := v.sealedAndToken("destroy")
+	if nil != err {
+		return fmt.Errorf("destroy: %w", err)
+	}
+
+	if sealed {
+		return fmt.Errorf("destroy: %w", ErrSealed)
+	}
+
+	v.client.SetToken(token)
+
+	if err = v.ensureKVVersion(); nil != err {
+		return fmt.Errorf("destroy: %w", err)
+	}
+
+	if v.kvVersion != 2 {
+		return fmt.Errorf("destroy: requires a KV v2 mount")
+	}
+
+	c := v.client.Logical()
+	if nil == c {
+		return errors.New("destroy: error creating logical client for Vault")
+	}
+
+	_, err = v.retryLogical("destroy", func() (*hvault.Secret, error) {
+		return c.Write(v.store+"/destroy/"+path, map[string]interface{}{"versions": versions})
+	})
+	if nil != err {
+		return fmt.Errorf("destroy: %w", err)
+	}
+
+	return
+}
+
+// Metadata - returns version metadata about the data under path, requires a KV v2 mount
+func (v *Client) Metadata(path string) (meta KVMetadata, err error) {
+
+	sealed, token, err := v.sealedAndToken("metadata")
+	if nil != err {
+		return meta, fmt.Errorf("metadata: %w", err)
+	}
+
+	if sealed {
+		return meta, fmt.Errorf("metadata: %w", ErrSealed)
+	}
+
+	v.client.SetToken(token)
+
+	if err = v.ensureKVVersion(); nil != err {
+		return meta, fmt.Errorf("metadata: %w", err)
+	}
+
+	if v.kvVersion != 2 {
+		return meta, fmt.Errorf("metadata: requires a KV v2 mount")
+	}
+
+	c := v.client.Logical()
+	if nil == c {
+		return meta, errors.New("metadata: error creating logical client for Vault")
+	}
+
+	secret, err := v.retryLogical("metadata", func() (*hvault.Secret, error) {
+		return c.Read(v.store + "/metadata/" + path)
+	})
+	if nil != err {
+		return meta, fmt.Errorf("metadata: %w", err)
+	}
+
+	if nil == secret {
+		return meta, fmt.Errorf("metadata: %w", NewKeyError(path))
+	}
+
+	meta.CurrentVersion = numberToInt(secret.Data["current_version"])
+	meta.OldestVersion = numberToInt(secret.Data["oldest_version"])
+
+	if ct, ok := secret.Data["created_time"].(string); ok {
+		meta.CreatedTime = ct
+	}
+	if ut, ok := secret.Data["updated_time"].(string); ok {
+		meta.UpdatedTime = ut
+	}
+
+	meta.Versions = make(map[string]Data)
+	if versions, ok := secret.Data["versions"].(map[string]interface{}); ok {
+		for k, val := range versions {
+			if vm, ok := val.(map[string]interface{}); ok {
+				meta.Versions[k] = Data(vm)
+			}
+		}
+	}
+
+	return meta, nil
+}
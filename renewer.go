@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	hvault "github.com/hashicorp/vault/api"
+	jww "github.com/spf13/jwalterweatherman"
+)
+
+// renewerInterval - how often the background renewer checks whether the current token needs renewing
+const renewerInterval = 15 * time.Second
+
+// renewerMaxInterval - the longest the background renewer will back off to after repeated recoverable errors
+const renewerMaxInterval = 5 * time.Minute
+
+// RenewalEvent - describes the outcome of a single background renewal attempt
+type RenewalEvent struct {
+	Renewed bool
+	Error   error
+	At      time.Time
+}
+
+// StartRenewer - starts a goroutine that calls `Auth.GetToken` every `renewerInterval`, so the token is renewed, or obtained by a fresh login when renewal fails, once the Auth implementation's cached expiry has passed; a lease that ends between two checks lapses before it is renewed. Every failed attempt, recoverable or not, doubles the interval up to `renewerMaxInterval`. The goroutine terminates when `ctx` is cancelled. It renews on a cloned `*hvault.Client` so it never races with `v.client.SetToken` calls made by concurrent foreground Read/Write/Delete/List calls.
+func (v *Client) StartRenewer(ctx context.Context) (err error) {
+
+	renewClient, err := v.client.Clone()
+	if nil != err {
+		return fmt.Errorf("startrenewer: %w", err)
+	}
+
+	if _, err = v.auth.GetToken(renewClient); nil != err {
+		return fmt.Errorf("startrenewer: %w", err)
+	}
+
+	v.renewalEvents = make(chan RenewalEvent, 8)
+
+	go v.renew(ctx, renewClient)
+
+	return
+}
+
+// RenewalEvents - returns a channel on which renewal events are emitted, callers should drain this to log or alert on renewal activity
+func (v *Client) RenewalEvents() <-chan RenewalEvent {
+	return v.renewalEvents
+}
+
+// renew - proactively renews the cached token on a timer until `ctx` is cancelled, using `renewClient` so it doesn't mutate the token on the shared `v.client` used by foreground calls
+func (v *Client) renew(ctx context.Context, renewClient *hvault.Client) {
+
+	interval := renewerInterval
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+	defer close(v.renewalEvents)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+			// AppRole, AWSAuth, KubernetesAuth and JWTAuth already fall back to a full login (`new()`) whenever their own renewal fails, so calling GetToken here covers both the renew and the re-authenticate-from-scratch cases; Token has no login path and returns the renewal error
+			_, err := v.auth.GetToken(renewClient)
+
+			event := RenewalEvent{Renewed: nil == err, Error: err, At: time.Now().UTC()}
+
+			select {
+			case v.renewalEvents <- event:
+			default: // don't block the renewer if the caller isn't draining events
+			}
+
+			switch {
+			case nil == err:
+				interval = renewerInterval
+			default:
+				if isUnrecoverable(err) {
+					jww.ERROR.Printf("renew: unrecoverable error, re-authentication failed, backing off: %s", err)
+				} else {
+					jww.ERROR.Printf("renew: recoverable error, backing off: %s", err)
+				}
+
+				interval *= 2
+				if interval > renewerMaxInterval {
+					interval = renewerMaxInterval
+				}
+			}
+
+			timer.Reset(interval)
+		}
+	}
+}
+
+// isUnrecoverable - reports whether an error does not classify as recoverable, e.g. permission denied, a revoked token, or any error `classify` does not recognise
+func isUnrecoverable(err error) bool {
+	return !IsRecoverable(classify(err))
+}
@@ -0,0 +1,117 @@
+package vault
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	hvault "github.com/hashicorp/vault/api"
+	jww "github.com/spf13/jwalterweatherman"
+)
+
+// classify - turns an error from the Vault API into either one of this package's sentinel errors (permission denied, token revoked - unrecoverable) or a RecoverableError (5xx, connection issues, seal-in-progress). Errors that don't match either are returned unchanged and treated as unrecoverable.
+func classify(err error) error {
+
+	if nil == err {
+		return nil
+	}
+
+	var respErr *hvault.ResponseError
+	if errors.As(err, &respErr) {
+		switch {
+		case respErr.StatusCode == 403 && containsAny(respErr.Errors, "token is revoked", "token revoked", "token not found"):
+			return fmt.Errorf("%w: %s", ErrTokenRevoked, err)
+		case respErr.StatusCode == 403:
+			return fmt.Errorf("%w: %s", ErrPermissionDenied, err)
+		case respErr.StatusCode == 404:
+			return err // missing mount/path, not safe to retry
+		case respErr.StatusCode >= 500:
+			return NewRecoverableError(err)
+		default:
+			return err
+		}
+	}
+
+	msg := err.Error()
+	if strings.Contains(msg, "context deadline exceeded") ||
+		strings.Contains(msg, "connection reset") ||
+		strings.Contains(msg, "EOF") ||
+		strings.Contains(msg, "vault is currently sealed") {
+		return NewRecoverableError(err)
+	}
+
+	return err
+}
+
+// containsAny - reports whether any string in `list` contains any of `subs`
+func containsAny(list []string, subs ...string) bool {
+	for _, s := range list {
+		for _, sub := range subs {
+			if strings.Contains(s, sub) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// doRetry - runs fn, retrying up to v.attempts times with v.retry second backoff while the returned error classifies as recoverable. A token-revoked error invalidates the cached Auth token so the next top-level call re-authenticates; anything else unrecoverable is returned immediately.
+func (v *Client) doRetry(action string, fn func() error) (err error) {
+
+	for attempt := 1; attempt <= v.attempts; attempt++ {
+		err = fn()
+		if nil == err {
+			return nil
+		}
+
+		classified := classify(err)
+
+		if errors.Is(classified, ErrTokenRevoked) {
+			v.auth.Invalidate()
+			return classified
+		}
+
+		if !IsRecoverable(classified) || attempt == v.attempts {
+			return classified
+		}
+
+		jww.INFO.Printf("%s: retrying after recoverable error (attempt %d of %d): %s", action, attempt, v.attempts, classified)
+		time.Sleep(time.Duration(v.retry) * time.Second)
+	}
+
+	return
+}
+
+// retryLogical - same retry/backoff behaviour as doRetry, for calls that return a *hvault.Secret
+func (v *Client) retryLogical(action string, fn func() (*hvault.Secret, error)) (secret *hvault.Secret, err error) {
+	err = v.doRetry(action, func() (fnErr error) {
+		secret, fnErr = fn()
+		return fnErr
+	})
+
+	return
+}
+
+// sealedAndToken - checks Vault's seal status and obtains a fresh token, retrying transient failures in both. If Vault is sealed, returns sealed=true immediately without attempting to fetch a token; the caller is responsible for surfacing ErrSealed.
+func (v *Client) sealedAndToken(action string) (sealed bool, token string, err error) {
+
+	err = v.doRetry(action, func() (fnErr error) {
+		sealed, fnErr = v.IsSealed()
+		return fnErr
+	})
+	if nil != err {
+		return false, "", err
+	}
+
+	if sealed {
+		return true, "", nil
+	}
+
+	err = v.doRetry(action, func() (fnErr error) {
+		token, fnErr = v.auth.GetToken(v.client)
+		return fnErr
+	})
+
+	return sealed, token, err
+}
@@ -0,0 +1,233 @@
+package vault
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	hvault "github.com/hashicorp/vault/api"
+)
+
+// TransitClient - wraps Vault's transit secrets engine mounted at a given path, letting callers encrypt/sign data without ever handling the underlying key
+type TransitClient struct {
+	v     *Client
+	mount string
+}
+
+// Transit - returns a TransitClient for the transit engine mounted at `mount`
+func (v *Client) Transit(mount string) *TransitClient {
+	return &TransitClient{v: v, mount: mount}
+}
+
+// write - performs an authenticated, seal-aware, retrying write against the transit engine, reusing the same token, seal-check and retry logic as Client.Write
+func (t *TransitClient) write(path string, d Data) (data Data, err error) {
+
+	sealed, token, err := t.v.sealedAndToken("write")
+	if nil != err {
+		return nil, fmt.Errorf("write: %w", err)
+	}
+
+	if sealed {
+		return nil, fmt.Errorf("write: %w", ErrSealed)
+	}
+
+	t.v.client.SetToken(token)
+
+	c := t.v.client.Logical()
+	if nil == c {
+		return nil, errors.New("write: error creating logical client for Vault")
+	}
+
+	secret, err := t.v.retryLogical("write", func() (*hvault.Secret, error) {
+		return c.Write(t.mount+"/"+path, d)
+	})
+	if nil != err {
+		return nil, fmt.Errorf("write: %w", err)
+	}
+
+	if nil != secret && nil != secret.Data {
+		data = secret.Data
+	}
+
+	return
+}
+
+// Encrypt - encrypts plaintext with the named key, context is optional and only required for keys with derivation enabled
+func (t *TransitClient) Encrypt(key string, plaintext, context []byte) (ciphertext string, err error) {
+
+	d := NewData()
+	d.SetString("plaintext", base64.StdEncoding.EncodeToString(plaintext))
+	if len(context) != 0 {
+		d.SetString("context", base64.StdEncoding.EncodeToString(context))
+	}
+
+	data, err := t.write("encrypt/"+key, d)
+	if nil != err {
+		return "", fmt.Errorf("encrypt: %w", err)
+	}
+
+	ciphertext = data.GetString("ciphertext")
+	if len(ciphertext) == 0 {
+		return "", fmt.Errorf("encrypt: %w", NewFieldError("ciphertext"))
+	}
+
+	return
+}
+
+// Decrypt - decrypts a ciphertext previously returned by Encrypt, context must match the one used to encrypt it
+func (t *TransitClient) Decrypt(key, ciphertext string, context []byte) (plaintext []byte, err error) {
+
+	d := NewData()
+	d.SetString("ciphertext", ciphertext)
+	if len(context) != 0 {
+		d.SetString("context", base64.StdEncoding.EncodeToString(context))
+	}
+
+	data, err := t.write("decrypt/"+key, d)
+	if nil != err {
+		return nil, fmt.Errorf("decrypt: %w", err)
+	}
+
+	encoded := data.GetString("plaintext")
+	if len(encoded) == 0 {
+		return nil, fmt.Errorf("decrypt: %w", NewFieldError("plaintext"))
+	}
+
+	return base64.StdEncoding.DecodeString(encoded)
+}
+
+// Sign - signs input with the named key, returning the versioned signature
+func (t *TransitClient) Sign(key string, input []byte) (signature string, err error) {
+
+	d := NewData()
+	d.SetString("input", base64.StdEncoding.EncodeToString(input))
+
+	data, err := t.write("sign/"+key, d)
+	if nil != err {
+		return "", fmt.Errorf("sign: %w", err)
+	}
+
+	signature = data.GetString("signature")
+	if len(signature) == 0 {
+		return "", fmt.Errorf("sign: %w", NewFieldError("signature"))
+	}
+
+	return
+}
+
+// Verify - verifies a signature previously returned by Sign against input
+func (t *TransitClient) Verify(key string, input []byte, signature string) (valid bool, err error) {
+
+	d := NewData()
+	d.SetString("input", base64.StdEncoding.EncodeToString(input))
+	d.SetString("signature", signature)
+
+	data, err := t.write("verify/"+key, d)
+	if nil != err {
+		return false, fmt.Errorf("verify: %w", err)
+	}
+
+	valid, _ = data["valid"].(bool) // Vault returns a native JSON boolean here, not a string like the rest of this library's Data fields
+	return
+}
+
+// Rewrap - re-encrypts a ciphertext under the latest version of the named key without exposing the plaintext
+func (t *TransitClient) Rewrap(key, ciphertext string, context []byte) (newCiphertext string, err error) {
+
+	d := NewData()
+	d.SetString("ciphertext", ciphertext)
+	if len(context) != 0 {
+		d.SetString("context", base64.StdEncoding.EncodeToString(context))
+	}
+
+	data, err := t.write("rewrap/"+key, d)
+	if nil != err {
+		return "", fmt.Errorf("rewrap: %w", err)
+	}
+
+	newCiphertext = data.GetString("ciphertext")
+	if len(newCiphertext) == 0 {
+		return "", fmt.Errorf("rewrap: %w", NewFieldError("ciphertext"))
+	}
+
+	return
+}
+
+// GenerateDataKey - generates a new data encryption key, returning the plaintext (unless `kind` is "wrapped") and the key wrapped by the named transit key, for envelope encryption
+func (t *TransitClient) GenerateDataKey(key, kind string) (plaintext, ciphertext []byte, err error) {
+
+	if kind != "wrapped" {
+		kind = "plaintext"
+	}
+
+	data, err := t.write("datakey/"+kind+"/"+key, NewData())
+	if nil != err {
+		return nil, nil, fmt.Errorf("generatedatakey: %w", err)
+	}
+
+	encodedCiphertext := data.GetString("ciphertext")
+	if len(encodedCiphertext) == 0 {
+		return nil, nil, fmt.Errorf("generatedatakey: %w", NewFieldError("ciphertext"))
+	}
+
+	ciphertext = []byte(encodedCiphertext) // the `vault:v<N>:...` wrapped key format is not base64, store it verbatim
+
+	if kind == "plaintext" {
+		encodedPlaintext := data.GetString("plaintext")
+		if len(encodedPlaintext) == 0 {
+			return nil, nil, fmt.Errorf("generatedatakey: %w", NewFieldError("plaintext"))
+		}
+
+		plaintext, err = base64.StdEncoding.DecodeString(encodedPlaintext)
+		if nil != err {
+			return nil, nil, fmt.Errorf("generatedatakey: %w", err)
+		}
+	}
+
+	return
+}
+
+// CreateKey - creates a new named encryption key, `d` carries key creation options such as "type" or "exportable"
+func (t *TransitClient) CreateKey(key string, d Data) (err error) {
+	_, err = t.write("keys/"+key, d)
+	if nil != err {
+		return fmt.Errorf("createkey: %w", err)
+	}
+	return
+}
+
+// RotateKey - rotates the named key to a new version, previous versions remain available for decryption
+func (t *TransitClient) RotateKey(key string) (err error) {
+	_, err = t.write("keys/"+key+"/rotate", NewData())
+	if nil != err {
+		return fmt.Errorf("rotatekey: %w", err)
+	}
+	return
+}
+
+// UpdateKeyConfig - updates configuration of the named key, such as "min_decryption_version" or "deletion_allowed"
+func (t *TransitClient) UpdateKeyConfig(key string, d Data) (err error) {
+	_, err = t.write("keys/"+key+"/config", d)
+	if nil != err {
+		return fmt.Errorf("updatekeyconfig: %w", err)
+	}
+	return
+}
+
+// CiphertextVersion - extracts the key version from a transit ciphertext of the form "vault:v<N>:...", returning 0 if it isn't in that format
+func CiphertextVersion(ciphertext string) int {
+
+	parts := strings.SplitN(ciphertext, ":", 3)
+	if len(parts) != 3 || parts[0] != "vault" || !strings.HasPrefix(parts[1], "v") {
+		return 0
+	}
+
+	version, err := strconv.Atoi(strings.TrimPrefix(parts[1], "v"))
+	if nil != err {
+		return 0
+	}
+
+	return version
+}
@@ -8,15 +8,18 @@ import hvault "github.com/hashicorp/vault/api"
 // Auth - interface for different types of authentication supported by this library, standard is `token` and `approle`
 type Auth interface {
 	GetToken(*hvault.Client) (string, error)
+	Invalidate() // drops any cached token/expiry so the next GetToken call re-authenticates from scratch
 }
 
 // Client - Vault Client instance and its related information
 type Client struct {
-	store    string
-	attempts int
-	retry    int
-	client   *hvault.Client
-	auth     Auth
+	store         string
+	attempts      int
+	retry         int
+	client        *hvault.Client
+	auth          Auth
+	renewalEvents chan RenewalEvent
+	kvVersion     int // 0 means not yet detected, see Client.ensureKVVersion
 }
 
 // Data - vault data format
@@ -11,9 +11,9 @@ import (
 	jww "github.com/spf13/jwalterweatherman"
 )
 
-// New - creates new instance of vault using the given information, other key pieces of information are based on the `VAULT_*` environment variables
+// New - creates new instance of vault using the given information, other key pieces of information are based on the `VAULT_*` environment variables. The KV engine version mounted at `store` is auto-detected on first use unless overridden with `WithKVVersion`.
 //func New(config *hvault.Config, auth Auth, store string, output io.Writer) (v *Client, err error) {
-func New(auth Auth, store string, output io.Writer) (v *Client, err error) {
+func New(auth Auth, store string, output io.Writer, opts ...Option) (v *Client, err error) {
 
 	jww.SetLogOutput(output)
 	jww.SetStdoutThreshold(jww.LevelInfo)
@@ -24,6 +24,10 @@ func New(auth Auth, store string, output io.Writer) (v *Client, err error) {
 	v.attempts = 12 // this can be hardcoded
 	v.retry = 5     // this can be hardcoded
 
+	for _, opt := range opts {
+		opt(v)
+	}
+
 	err = v.ok()
 	if nil != err {
 		return nil, fmt.Errorf("new: %w", err)
@@ -116,32 +120,42 @@ func (v *Client) ok() (err error) {
 	return
 }
 
-// Write - writes the vault data to the given path, this will **COMPLETELY** replace all values in the path
-func (v *Client) Write(path string, d Data) (data Data, err error) {
+// Write - writes the vault data to the given path, this will **COMPLETELY** replace all values in the path. `opts` is accepted for KV v2 mounts to set a CAS value, and is ignored otherwise.
+func (v *Client) Write(path string, d Data, opts ...WriteOptions) (data Data, err error) {
 
-	sealed, err := v.IsSealed()
+	sealed, token, err := v.sealedAndToken("write")
 	if nil != err {
-		return
+		return nil, fmt.Errorf("write: %w", err)
 	}
 
 	if sealed {
-		return nil, fmt.Errorf("write: vault is currently sealed")
+		return nil, fmt.Errorf("write: %w", ErrSealed)
 	}
 
-	// get a valid token and connect to Vault
-	token, err := v.auth.GetToken(v.client)
-	if nil != err {
+	v.client.SetToken(token)
+
+	if err = v.ensureKVVersion(); nil != err {
 		return nil, fmt.Errorf("write: %w", err)
 	}
 
-	v.client.SetToken(token)
-
 	c := v.client.Logical()
 	if nil == c {
 		return nil, errors.New("write: error creating logical client for Vault")
 	}
 
-	secret, err := c.Write(v.store+"/"+path, d)
+	payload := map[string]interface{}(d)
+
+	if v.kvVersion == 2 {
+		wrapped := map[string]interface{}{"data": d}
+		if len(opts) != 0 && nil != opts[0].CAS {
+			wrapped["options"] = map[string]interface{}{"cas": *opts[0].CAS}
+		}
+		payload = wrapped
+	}
+
+	secret, err := v.retryLogical("write", func() (*hvault.Secret, error) {
+		return c.Write(v.dataPath(path), payload)
+	})
 	if nil != err {
 		return nil, fmt.Errorf("write: %w", err)
 	}
@@ -169,29 +183,29 @@ func (v *Client) WriteKey(path, field, value string) (data Data, err error) {
 // Read - reads all the fields under the given path
 func (v *Client) Read(path string) (data Data, err error) {
 
-	sealed, err := v.IsSealed()
+	sealed, token, err := v.sealedAndToken("read")
 	if nil != err {
-		return
+		return nil, fmt.Errorf("read: %w", err)
 	}
 
 	if sealed {
-		return nil, fmt.Errorf("read: vault is currently sealed")
+		return nil, fmt.Errorf("read: %w", ErrSealed)
 	}
 
-	// get a valid token and connect to Vault
-	token, err := v.auth.GetToken(v.client)
-	if nil != err {
+	v.client.SetToken(token)
+
+	if err = v.ensureKVVersion(); nil != err {
 		return nil, fmt.Errorf("read: %w", err)
 	}
 
-	v.client.SetToken(token)
-
 	c := v.client.Logical()
 	if nil == c {
 		return nil, errors.New("read: error creating logical client for Vault")
 	}
 
-	secret, err := c.Read(v.store + "/" + path)
+	secret, err := v.retryLogical("read", func() (*hvault.Secret, error) {
+		return c.Read(v.dataPath(path))
+	})
 	if err != nil {
 		return nil, fmt.Errorf("read: %w", err)
 	}
@@ -200,6 +214,10 @@ func (v *Client) Read(path string) (data Data, err error) {
 		return nil, fmt.Errorf("read: %w", NewKeyError(path))
 	}
 
+	if v.kvVersion == 2 {
+		return unwrapKVv2Data(secret.Data)
+	}
+
 	return secret.Data, nil
 }
 
@@ -223,29 +241,29 @@ func (v *Client) ReadKey(path string, field string) (value string, err error) {
 // Delete - delete the given key
 func (v *Client) Delete(path string) (data Data, err error) {
 
-	sealed, err := v.IsSealed()
+	sealed, token, err := v.sealedAndToken("delete")
 	if nil != err {
-		return
+		return nil, fmt.Errorf("delete: %w", err)
 	}
 
 	if sealed {
-		return nil, fmt.Errorf("delete: vault is currently sealed")
+		return nil, fmt.Errorf("delete: %w", ErrSealed)
 	}
 
-	// get a valid token and connect to Vault
-	token, err := v.auth.GetToken(v.client)
-	if nil != err {
+	v.client.SetToken(token)
+
+	if err = v.ensureKVVersion(); nil != err {
 		return nil, fmt.Errorf("delete: %w", err)
 	}
 
-	v.client.SetToken(token)
-
 	c := v.client.Logical()
 	if nil == c {
 		return nil, errors.New("delete: error creating logical client for Vault")
 	}
 
-	secret, err := c.Delete(v.store + "/" + path)
+	secret, err := v.retryLogical("delete", func() (*hvault.Secret, error) {
+		return c.Delete(v.dataPath(path))
+	})
 	if nil != err {
 		return nil, fmt.Errorf("delete: %w", err) // reformat the error message for consistency
 	}
@@ -260,29 +278,29 @@ func (v *Client) Delete(path string) (data Data, err error) {
 // List - list keys under a given path
 func (v *Client) List(path string) (keys []string, err error) {
 
-	sealed, err := v.IsSealed()
+	sealed, token, err := v.sealedAndToken("list")
 	if nil != err {
-		return
+		return nil, fmt.Errorf("list: %w", err)
 	}
 
 	if sealed {
-		return nil, fmt.Errorf("delete: vault is currently sealed")
-	}
-
-	// get a valid token and connect to Vault
-	token, err := v.auth.GetToken(v.client)
-	if nil != err {
-		return nil, fmt.Errorf("delete: %w", err)
+		return nil, fmt.Errorf("list: %w", ErrSealed)
 	}
 
 	v.client.SetToken(token)
 
+	if err = v.ensureKVVersion(); nil != err {
+		return nil, fmt.Errorf("list: %w", err)
+	}
+
 	c := v.client.Logical()
 	if nil == c {
 		return nil, errors.New("list: error creating logical client for Vault")
 	}
 
-	secret, err := c.List(v.store + "/" + path)
+	secret, err := v.retryLogical("list", func() (*hvault.Secret, error) {
+		return c.List(v.listPath(path))
+	})
 	if nil != err {
 		return
 	}
@@ -0,0 +1,173 @@
+package vault
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	hvault "github.com/hashicorp/vault/api"
+)
+
+// WrapWrite - writes `d` to `path` same as Write, but returns a wrapping token valid for `ttl` instead of the written data, letting the caller hand the token to another process in place of the secret itself
+func (v *Client) WrapWrite(path string, d Data, ttl time.Duration) (wrapToken string, err error) {
+
+	sealed, token, err := v.sealedAndToken("wrapwrite")
+	if nil != err {
+		return "", fmt.Errorf("wrapwrite: %w", err)
+	}
+
+	if sealed {
+		return "", fmt.Errorf("wrapwrite: %w", ErrSealed)
+	}
+
+	v.client.SetToken(token)
+
+	if err = v.ensureKVVersion(); nil != err {
+		return "", fmt.Errorf("wrapwrite: %w", err)
+	}
+
+	v.client.SetWrappingLookupFunc(func(operation, path string) string {
+		return ttl.String()
+	})
+	defer v.client.SetWrappingLookupFunc(nil)
+
+	c := v.client.Logical()
+	if nil == c {
+		return "", errors.New("wrapwrite: error creating logical client for Vault")
+	}
+
+	payload := map[string]interface{}(d)
+	if v.kvVersion == 2 {
+		payload = map[string]interface{}{"data": d}
+	}
+
+	secret, err := v.retryLogical("wrapwrite", func() (*hvault.Secret, error) {
+		return c.Write(v.dataPath(path), payload)
+	})
+	if nil != err {
+		return "", fmt.Errorf("wrapwrite: %w", err)
+	}
+
+	if nil == secret || nil == secret.WrapInfo {
+		return "", fmt.Errorf("wrapwrite: vault did not return a wrap token")
+	}
+
+	return secret.WrapInfo.Token, nil
+}
+
+// WrapRead - reads `path` same as Read, but returns a wrapping token valid for `ttl` instead of the data itself, letting the caller hand the token to another process in place of the secret itself
+func (v *Client) WrapRead(path string, ttl time.Duration) (wrapToken string, err error) {
+
+	sealed, token, err := v.sealedAndToken("wrapread")
+	if nil != err {
+		return "", fmt.Errorf("wrapread: %w", err)
+	}
+
+	if sealed {
+		return "", fmt.Errorf("wrapread: %w", ErrSealed)
+	}
+
+	v.client.SetToken(token)
+
+	if err = v.ensureKVVersion(); nil != err {
+		return "", fmt.Errorf("wrapread: %w", err)
+	}
+
+	v.client.SetWrappingLookupFunc(func(operation, path string) string {
+		return ttl.String()
+	})
+	defer v.client.SetWrappingLookupFunc(nil)
+
+	c := v.client.Logical()
+	if nil == c {
+		return "", errors.New("wrapread: error creating logical client for Vault")
+	}
+
+	secret, err := v.retryLogical("wrapread", func() (*hvault.Secret, error) {
+		return c.Read(v.dataPath(path))
+	})
+	if nil != err {
+		return "", fmt.Errorf("wrapread: %w", err)
+	}
+
+	if nil == secret || nil == secret.WrapInfo {
+		return "", fmt.Errorf("wrapread: vault did not return a wrap token")
+	}
+
+	return secret.WrapInfo.Token, nil
+}
+
+// Unwrap - exchanges a wrapping token for the data it wraps, generalising the unwrap step `AppRole` already does internally for secret_ids to work for any response-wrapped secret
+func (v *Client) Unwrap(token string) (data Data, err error) {
+
+	// Unwrap authenticates with the caller-supplied wrapping token itself, not v.auth, so only the seal-check half of sealedAndToken applies here
+	var sealed bool
+	err = v.doRetry("unwrap", func() (fnErr error) {
+		sealed, fnErr = v.IsSealed()
+		return fnErr
+	})
+	if nil != err {
+		return nil, fmt.Errorf("unwrap: %w", err)
+	}
+
+	if sealed {
+		return nil, fmt.Errorf("unwrap: %w", ErrSealed)
+	}
+
+	v.client.SetToken(token)
+
+	c := v.client.Logical()
+	if nil == c {
+		return nil, errors.New("unwrap: error creating logical client for Vault")
+	}
+
+	secret, err := v.retryLogical("unwrap", func() (*hvault.Secret, error) {
+		return c.Write("sys/wrapping/unwrap", nil)
+	})
+	if nil != err {
+		return nil, fmt.Errorf("unwrap: %w", err)
+	}
+
+	if nil == secret || nil == secret.Data {
+		return nil, fmt.Errorf("unwrap: %w", NewKeyError("sys/wrapping/unwrap"))
+	}
+
+	return secret.Data, nil
+}
+
+// WrapLookup - looks up the creation path and creation TTL (the TTL the token was wrapped with, not the time left on it) of a wrapping token without consuming it
+func (v *Client) WrapLookup(token string) (creationPath string, creationTTL time.Duration, err error) {
+
+	sealed, vaultToken, err := v.sealedAndToken("wraplookup")
+	if nil != err {
+		return "", 0, fmt.Errorf("wraplookup: %w", err)
+	}
+
+	if sealed {
+		return "", 0, fmt.Errorf("wraplookup: %w", ErrSealed)
+	}
+
+	v.client.SetToken(vaultToken)
+
+	c := v.client.Logical()
+	if nil == c {
+		return "", 0, errors.New("wraplookup: error creating logical client for Vault")
+	}
+
+	secret, err := v.retryLogical("wraplookup", func() (*hvault.Secret, error) {
+		return c.Write("sys/wrapping/lookup", map[string]interface{}{"token": token})
+	})
+	if nil != err {
+		return "", 0, fmt.Errorf("wraplookup: %w", err)
+	}
+
+	if nil == secret || nil == secret.Data {
+		return "", 0, fmt.Errorf("wraplookup: %w", NewKeyError("sys/wrapping/lookup"))
+	}
+
+	d := Data(secret.Data)
+	creationPath = d.GetString("creation_path")
+	creationTTL = time.Duration(d.GetInt64("creation_ttl")) * time.Second
+
+	return
+}